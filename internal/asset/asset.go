@@ -0,0 +1,36 @@
+// Package asset provides a pluggable AssetManager that resolves sprite and
+// font lookups through a layered list of sources: built-ins embedded into
+// the binary, the user's data directory, and explicit override paths from
+// config.Env. Later sources take priority, so a user can drop a replacement
+// PNG or TTF into their data dir and have it picked up without a rebuild.
+package asset
+
+import (
+	"io"
+
+	"golang.org/x/image/font"
+)
+
+// Asset is the raw, loaded contents of a registered asset along with the ID
+// it was looked up under.
+type Asset struct {
+	ID   string
+	Data []byte
+}
+
+// Loader produces an Asset's bytes on demand. Reader is returned rather than
+// []byte so large assets don't need to be buffered by the loader itself.
+type Loader func() (io.ReadCloser, error)
+
+// Manager resolves asset IDs to loaded Assets and fonts, searching its
+// layered sources in priority order.
+type Manager interface {
+	// Load resolves id against the registered sources and returns its bytes.
+	Load(id string) (Asset, error)
+	// LoadFont resolves id the same way as Load, then parses it as a TTF at
+	// the given point size.
+	LoadFont(id string, size float64) (font.Face, error)
+	// Register attaches a Loader to id in the lowest-priority (built-in)
+	// layer; call it once per embedded asset at startup.
+	Register(id string, loader Loader)
+}
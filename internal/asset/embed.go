@@ -0,0 +1,35 @@
+package asset
+
+import (
+	"bytes"
+	"embed"
+	"io"
+	"path/filepath"
+)
+
+//go:embed builtin/*.png builtin/*.ttf
+var embedded embed.FS
+
+// registerEmbedded walks the embedded builtin directory and registers each
+// file's base name (e.g. "herb1.png") as a Loader on the builtin source, so
+// it's found before NewManager even looks at a user data dir or override
+// path.
+func registerEmbedded(b *builtinSource) {
+	entries, err := embedded.ReadDir("builtin")
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		b.loaders[name] = func() (io.ReadCloser, error) {
+			data, err := embedded.ReadFile(filepath.Join("builtin", name))
+			if err != nil {
+				return nil, err
+			}
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package asset
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+
+	"github.com/arsham/lify/internal/config"
+)
+
+// manager is the default Manager. It searches its sources from lowest to
+// highest priority and returns the first hit, so a later source always
+// overrides an earlier one with the same ID.
+type manager struct {
+	builtin *builtinSource
+	sources []source // searched in order, highest priority last
+}
+
+// NewManager builds a Manager with the standard layering: built-ins
+// embedded in the binary, then env's user data directory, then env's
+// explicit override paths, in that priority order.
+func NewManager(env *config.Env) *manager {
+	m := &manager{builtin: newBuiltinSource()}
+	registerEmbedded(m.builtin)
+	m.sources = append(m.sources, m.builtin)
+	if env.UserDataDir != "" {
+		m.sources = append(m.sources, newDirSource(env.UserDataDir))
+	}
+	for _, p := range env.AssetOverridePaths {
+		m.sources = append(m.sources, newDirSource(p))
+	}
+	return m
+}
+
+// Register implements Manager.
+func (m *manager) Register(id string, loader Loader) {
+	m.builtin.loaders[id] = loader
+}
+
+// Load implements Manager.
+func (m *manager) Load(id string) (Asset, error) {
+	var rc io.ReadCloser
+	var err error
+	for i := len(m.sources) - 1; i >= 0; i-- {
+		rc, err = m.sources[i].open(id)
+		if err == nil {
+			break
+		}
+	}
+	if rc == nil {
+		return Asset{}, fmt.Errorf("load asset %q: %w", id, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return Asset{}, fmt.Errorf("read asset %q: %w", id, err)
+	}
+	return Asset{ID: id, Data: data}, nil
+}
+
+// LoadFont implements Manager.
+func (m *manager) LoadFont(id string, size float64) (font.Face, error) {
+	a, err := m.Load(id)
+	if err != nil {
+		return nil, fmt.Errorf("load font %q: %w", id, err)
+	}
+	f, err := truetype.Parse(a.Data)
+	if err != nil {
+		return nil, fmt.Errorf("parse font %q: %w", id, err)
+	}
+	return truetype.NewFace(f, &truetype.Options{Size: size}), nil
+}
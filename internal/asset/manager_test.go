@@ -0,0 +1,81 @@
+package asset
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/arsham/lify/internal/config"
+)
+
+// writeAsset drops id's contents into dir, creating dir if needed.
+func writeAsset(t *testing.T, dir, id, contents string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("mkdir %s: %v", dir, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, id), []byte(contents), 0o644); err != nil {
+		t.Fatalf("write %s: %v", filepath.Join(dir, id), err)
+	}
+}
+
+// stringLoader returns a Loader that always reads back contents, standing
+// in for a registered built-in asset.
+func stringLoader(contents string) Loader {
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(contents)), nil
+	}
+}
+
+// TestManager_LoadPriority checks the layering NewManager documents: a
+// later source always overrides an earlier one with the same ID, so
+// override paths beat UserDataDir, which beats the built-ins.
+func TestManager_LoadPriority(t *testing.T) {
+	const id = "herb1.png"
+
+	m := NewManager(&config.Env{
+		UserDataDir:        filepath.Join(t.TempDir(), "userdata"),
+		AssetOverridePaths: []string{filepath.Join(t.TempDir(), "override1"), filepath.Join(t.TempDir(), "override2")},
+	})
+	m.Register(id, stringLoader("builtin"))
+
+	a, err := m.Load(id)
+	if err != nil {
+		t.Fatalf("Load builtin: %v", err)
+	}
+	if string(a.Data) != "builtin" {
+		t.Fatalf("builtin: got %q, want %q", a.Data, "builtin")
+	}
+
+	userDataDir := m.sources[1].(*dirSource).root
+	writeAsset(t, userDataDir, id, "userdata")
+	a, err = m.Load(id)
+	if err != nil {
+		t.Fatalf("Load userdata: %v", err)
+	}
+	if string(a.Data) != "userdata" {
+		t.Fatalf("userdata should override builtin: got %q", a.Data)
+	}
+
+	override1Dir := m.sources[2].(*dirSource).root
+	writeAsset(t, override1Dir, id, "override1")
+	a, err = m.Load(id)
+	if err != nil {
+		t.Fatalf("Load override1: %v", err)
+	}
+	if string(a.Data) != "override1" {
+		t.Fatalf("override1 should override userdata: got %q", a.Data)
+	}
+
+	override2Dir := m.sources[3].(*dirSource).root
+	writeAsset(t, override2Dir, id, "override2")
+	a, err = m.Load(id)
+	if err != nil {
+		t.Fatalf("Load override2: %v", err)
+	}
+	if string(a.Data) != "override2" {
+		t.Fatalf("override2 should override override1: got %q", a.Data)
+	}
+}
@@ -0,0 +1,51 @@
+package asset
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// source resolves a single asset ID to its bytes, or reports that it
+// doesn't have that ID.
+type source interface {
+	open(id string) (io.ReadCloser, error)
+}
+
+// builtinSource serves assets registered via Manager.Register, i.e. the
+// ones embedded into the binary with //go:embed.
+type builtinSource struct {
+	loaders map[string]Loader
+}
+
+func newBuiltinSource() *builtinSource {
+	return &builtinSource{loaders: make(map[string]Loader)}
+}
+
+func (b *builtinSource) open(id string) (io.ReadCloser, error) {
+	loader, ok := b.loaders[id]
+	if !ok {
+		return nil, fmt.Errorf("no builtin asset registered for %q", id)
+	}
+	return loader()
+}
+
+// dirSource serves assets from a directory on disk, matching id against a
+// file of the same name under root. Used for both the user data dir and
+// explicit override paths.
+type dirSource struct {
+	root string
+}
+
+func newDirSource(root string) *dirSource {
+	return &dirSource{root: root}
+}
+
+func (d *dirSource) open(id string) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Join(d.root, id))
+	if err != nil {
+		return nil, fmt.Errorf("open %q in %s: %w", id, d.root, err)
+	}
+	return f, nil
+}
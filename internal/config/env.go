@@ -0,0 +1,20 @@
+// Package config holds environment-derived configuration shared across the
+// UI and asset layers.
+package config
+
+// Env is the configuration resolved at startup from flags/environment
+// variables.
+type Env struct {
+	UI struct {
+		Width  int
+		Height int
+	}
+
+	// UserDataDir is where a user's own asset overrides live, e.g.
+	// ~/.local/share/lify. Empty disables this asset layer.
+	UserDataDir string
+
+	// AssetOverridePaths are additional directories the AssetManager
+	// checks after UserDataDir, in priority order (last wins).
+	AssetOverridePaths []string
+}
@@ -0,0 +1,33 @@
+package ecs
+
+import "github.com/paulmach/orb"
+
+// Position is the world-space location of an entity.
+type Position struct {
+	Point orb.Point
+}
+
+// Renderable holds the asset an entity is drawn with and whether it should
+// currently be skipped by RenderSystem.
+type Renderable struct {
+	AssetID string
+	Hidden  bool
+}
+
+// Hunger tracks how fed an entity is. SpawnSystem and DecaySystem read it to
+// decide when an entity reproduces or starves.
+type Hunger struct {
+	Value float64
+	Max   float64
+}
+
+// Age tracks how long an entity has existed, in seconds.
+type Age struct {
+	Seconds float64
+}
+
+// Species names the kind of entity, e.g. "herb" or "predator", so systems
+// can branch on behaviour without type-asserting concrete entity structs.
+type Species struct {
+	Name string
+}
@@ -0,0 +1,5 @@
+package ecs
+
+// EntityID uniquely identifies an entity within a World. It carries no
+// meaning on its own; all state lives in the component maps it indexes into.
+type EntityID uint64
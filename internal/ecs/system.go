@@ -0,0 +1,55 @@
+package ecs
+
+// System processes a subset of a World's components once per Tick.
+type System interface {
+	Update(w *World, dt float64)
+}
+
+// MovementSystem advances entity Positions. It is currently a hook for
+// velocity/steering components that will land with the food-chain work.
+type MovementSystem struct{}
+
+// Update implements System.
+func (MovementSystem) Update(w *World, dt float64) {}
+
+// AgeSystem increments Age.Seconds for every aged entity.
+type AgeSystem struct{}
+
+// Update implements System.
+func (AgeSystem) Update(w *World, dt float64) {
+	for id, age := range w.Ages {
+		age.Seconds += dt
+		w.Ages[id] = age
+	}
+}
+
+// SpawnSystem turns well-fed entities into offspring. The concrete spawning
+// rules land with the reproduction work; for now it is a no-op hook so the
+// system order is already correct once they're implemented.
+type SpawnSystem struct{}
+
+// Update implements System.
+func (SpawnSystem) Update(w *World, dt float64) {}
+
+// DecaySystem removes entities whose Hunger has run out.
+type DecaySystem struct{}
+
+// Update implements System.
+func (DecaySystem) Update(w *World, dt float64) {
+	for id, h := range w.Hungers {
+		h.Value -= dt
+		if h.Value <= 0 {
+			w.Remove(id)
+			continue
+		}
+		w.Hungers[id] = h
+	}
+}
+
+// RenderSystem doesn't mutate World state; it exists so rendering can be
+// ordered alongside gameplay systems in the Tick pipeline. The UI layer owns
+// the actual draw calls and reads Renderables/Positions after Tick returns.
+type RenderSystem struct{}
+
+// Update implements System.
+func (RenderSystem) Update(w *World, dt float64) {}
@@ -0,0 +1,61 @@
+package ecs
+
+import "sync"
+
+// World owns every entity and its components, and drives the systems that
+// operate on them each tick. Components are stored as typed maps keyed by
+// EntityID rather than attached to the entity itself, so systems only pay
+// for the components they actually touch.
+type World struct {
+	mu     sync.RWMutex
+	nextID EntityID
+
+	systems []System
+
+	Positions   map[EntityID]Position
+	Renderables map[EntityID]Renderable
+	Hungers     map[EntityID]Hunger
+	Ages        map[EntityID]Age
+	Species     map[EntityID]Species
+}
+
+// NewWorld creates an empty World. Systems run in the order given on every
+// Tick.
+func NewWorld(systems ...System) *World {
+	return &World{
+		systems:     systems,
+		Positions:   make(map[EntityID]Position),
+		Renderables: make(map[EntityID]Renderable),
+		Hungers:     make(map[EntityID]Hunger),
+		Ages:        make(map[EntityID]Age),
+		Species:     make(map[EntityID]Species),
+	}
+}
+
+// NewEntity allocates a fresh EntityID. Callers attach components by writing
+// into the relevant World maps directly, or via a higher-level factory such
+// as ui.NewEntity.
+func (w *World) NewEntity() EntityID {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.nextID++
+	return w.nextID
+}
+
+// Remove deletes an entity and all of its components from the World.
+func (w *World) Remove(id EntityID) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.Positions, id)
+	delete(w.Renderables, id)
+	delete(w.Hungers, id)
+	delete(w.Ages, id)
+	delete(w.Species, id)
+}
+
+// Tick advances every registered system by dt seconds.
+func (w *World) Tick(dt float64) {
+	for _, sys := range w.systems {
+		sys.Update(w, dt)
+	}
+}
@@ -0,0 +1,17 @@
+// Package food holds the passive, edible entity kinds in the simulation's
+// food chain.
+package food
+
+// Herb is a passive food-chain entity: it doesn't move or hunt, it just
+// exists on the Board until eaten or decayed.
+type Herb struct {
+	Name string
+}
+
+// NewHerb creates a Herb with the given display name.
+func NewHerb(name string) *Herb {
+	return &Herb{Name: name}
+}
+
+// Species implements ui.Behaviour.
+func (h *Herb) Species() string { return "herb" }
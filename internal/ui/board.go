@@ -0,0 +1,154 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"sync"
+
+	"github.com/oakmound/oak/v4/render"
+	"github.com/paulmach/orb"
+	"golang.org/x/image/font"
+
+	"github.com/arsham/lify/internal/asset"
+	"github.com/arsham/lify/internal/config"
+	"github.com/arsham/lify/internal/ecs"
+)
+
+// fontSize is the point size every font resolved through Board.Font is
+// rendered at.
+const fontSize = 18
+
+// Board owns the simulation World, the spatial index over its entities,
+// and the AssetManager used to resolve sprites and fonts. Scenes read and
+// write entities through it rather than touching the World, the grid, or
+// the asset sources directly.
+type Board struct {
+	mu     sync.RWMutex
+	env    *config.Env
+	assets asset.Manager
+	world  *ecs.World
+	grid   *grid
+	bound  orb.Bound
+
+	entities map[ecs.EntityID]*Entity
+}
+
+// NewBoard creates a Board bounded by bound, resolving assets through
+// assets instead of doing its own file lookups.
+func NewBoard(env *config.Env, assets asset.Manager, bound orb.Bound) *Board {
+	return &Board{
+		env:    env,
+		assets: assets,
+		world: ecs.NewWorld(
+			ecs.MovementSystem{},
+			ecs.AgeSystem{},
+			ecs.SpawnSystem{},
+			ecs.DecaySystem{},
+			ecs.RenderSystem{},
+		),
+		grid:     newGrid(),
+		bound:    bound,
+		entities: make(map[ecs.EntityID]*Entity),
+	}
+}
+
+// Load resolves every built-in asset the simulation needs up front,
+// reporting progress as it goes.
+func (b *Board) Load(progress func(done, total int, label string)) error {
+	ids := boardAssetIDs
+	for i, id := range ids {
+		progress(i, len(ids), fmt.Sprintf("Loading %s...", id))
+		if _, err := b.assets.Load(id); err != nil {
+			return fmt.Errorf("load %q: %w", id, err)
+		}
+	}
+	progress(len(ids), len(ids), "Assets loaded")
+	return nil
+}
+
+// Font resolves id to a font face through the Board's AssetManager.
+func (b *Board) Font(id string) font.Face {
+	f, err := b.assets.LoadFont(id, fontSize)
+	if err != nil {
+		return nil
+	}
+	return f
+}
+
+// Asset resolves id to a drawable sprite through the Board's AssetManager,
+// decoding it from PNG bytes.
+func (b *Board) Asset(id string) (render.Modifiable, error) {
+	a, err := b.assets.Load(id)
+	if err != nil {
+		return nil, fmt.Errorf("load asset %q: %w", id, err)
+	}
+	img, err := png.Decode(bytes.NewReader(a.Data))
+	if err != nil {
+		return nil, fmt.Errorf("decode asset %q: %w", id, err)
+	}
+	return render.NewSprite(0, 0, img), nil
+}
+
+// Bound returns the world bounds entities may be spawned within.
+func (b *Board) Bound() orb.Bound { return b.bound }
+
+// World returns the ECS World this Board drives each tick.
+func (b *Board) World() *ecs.World { return b.world }
+
+// Add assigns e a World identity, records it on the Board, inserts it into
+// the spatial index, and seeds its components so AgeSystem, SpawnSystem,
+// and DecaySystem actually have something to iterate.
+func (b *Board) Add(e *Entity) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e.id = b.world.NewEntity()
+	b.entities[e.id] = e
+	b.grid.Insert(e)
+	b.world.Positions[e.id] = ecs.Position{Point: e.point}
+	b.world.Renderables[e.id] = ecs.Renderable{AssetID: e.assetID}
+	b.world.Hungers[e.id] = ecs.Hunger{Value: initialHunger, Max: initialHunger}
+	b.world.Ages[e.id] = ecs.Age{}
+	b.world.Species[e.id] = ecs.Species{Name: e.species}
+	return nil
+}
+
+// Move relocates an already-added entity, keeping the spatial index and the
+// ECS Position component in sync. Any future position mutation must go
+// through this method rather than Entity.SetPoint directly.
+func (b *Board) Move(e *Entity, newPoint orb.Point) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.grid.Move(e, newPoint)
+	b.world.Positions[e.id] = ecs.Position{Point: newPoint}
+}
+
+// EntitiesIn returns every entity within the axis-aligned box
+// (minX,minY)-(maxX,maxY), answered from the spatial index rather than a
+// linear scan.
+func (b *Board) EntitiesIn(minX, minY, maxX, maxY int) []*Entity {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.grid.Query(orb.Bound{
+		Min: orb.Point{float64(minX), float64(minY)},
+		Max: orb.Point{float64(maxX), float64(maxY)},
+	})
+}
+
+// Count returns the number of entities currently on the Board.
+func (b *Board) Count() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.entities)
+}
+
+// Clear removes every entity from the Board and its spatial index.
+func (b *Board) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for id := range b.entities {
+		b.world.Remove(id)
+		b.grid.Remove(id)
+	}
+	b.entities = make(map[ecs.EntityID]*Entity)
+}
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"image"
+
+	"github.com/paulmach/orb"
+)
+
+// minZoom and maxZoom bound how far Camera.ZoomAt can scale the view.
+const (
+	minZoom = 0.1
+	maxZoom = 8.0
+)
+
+// Camera maps between world space and screen space so startLifyScene can
+// pan and zoom instead of rendering a single fixed viewport.
+type Camera struct {
+	Pos          orb.Point
+	Zoom         float64
+	ViewportSize image.Point
+}
+
+// NewCamera creates a Camera centred at pos at 1x zoom, sized to viewport.
+func NewCamera(pos orb.Point, viewport image.Point) *Camera {
+	return &Camera{Pos: pos, Zoom: 1, ViewportSize: viewport}
+}
+
+// Pan moves the camera by dx, dy world units, scaled so that panning feels
+// consistent regardless of the current zoom level.
+func (c *Camera) Pan(dx, dy float64) {
+	c.Pos = orb.Point{c.Pos.X() + dx/c.Zoom, c.Pos.Y() + dy/c.Zoom}
+}
+
+// ZoomAt scales the camera by factor, keeping the world point under
+// screenPt fixed on screen.
+func (c *Camera) ZoomAt(factor float64, screenPt image.Point) {
+	before := c.ScreenToWorld(screenPt)
+	c.Zoom *= factor
+	if c.Zoom < minZoom {
+		c.Zoom = minZoom
+	} else if c.Zoom > maxZoom {
+		c.Zoom = maxZoom
+	}
+	after := c.ScreenToWorld(screenPt)
+	c.Pos = orb.Point{
+		c.Pos.X() + before.X() - after.X(),
+		c.Pos.Y() + before.Y() - after.Y(),
+	}
+}
+
+// WorldToScreen converts a world-space point to its screen-space position
+// under the current pan and zoom.
+func (c *Camera) WorldToScreen(p orb.Point) image.Point {
+	return image.Point{
+		X: int((p.X()-c.Pos.X())*c.Zoom) + c.ViewportSize.X/2,
+		Y: int((p.Y()-c.Pos.Y())*c.Zoom) + c.ViewportSize.Y/2,
+	}
+}
+
+// ScreenToWorld converts a screen-space point back to world space.
+func (c *Camera) ScreenToWorld(p image.Point) orb.Point {
+	return orb.Point{
+		(float64(p.X-c.ViewportSize.X/2))/c.Zoom + c.Pos.X(),
+		(float64(p.Y-c.ViewportSize.Y/2))/c.Zoom + c.Pos.Y(),
+	}
+}
+
+// WorldBounds returns the world-space rectangle currently visible through
+// the camera, suitable for Board.EntitiesIn / the spatial index's Query.
+func (c *Camera) WorldBounds() orb.Bound {
+	min := c.ScreenToWorld(image.Point{0, 0})
+	max := c.ScreenToWorld(c.ViewportSize)
+	return orb.Bound{Min: min, Max: max}
+}
@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"image"
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+// approxEqual reports whether a and b are within a small epsilon, to
+// tolerate the float64 round-trip error a WorldToScreen/ScreenToWorld pair
+// picks up from truncating to int pixels.
+func approxEqual(a, b, epsilon float64) bool {
+	return math.Abs(a-b) <= epsilon
+}
+
+func TestCamera_WorldScreenRoundTrip(t *testing.T) {
+	c := NewCamera(orb.Point{100, 200}, image.Point{800, 600})
+	c.Zoom = 2
+
+	world := orb.Point{150, 250}
+	screen := c.WorldToScreen(world)
+	back := c.ScreenToWorld(screen)
+
+	if !approxEqual(back.X(), world.X(), 1) || !approxEqual(back.Y(), world.Y(), 1) {
+		t.Fatalf("round trip: got %v, want close to %v", back, world)
+	}
+}
+
+func TestCamera_ZoomAtClampsToBounds(t *testing.T) {
+	c := NewCamera(orb.Point{0, 0}, image.Point{800, 600})
+
+	for i := 0; i < 50; i++ {
+		c.ZoomAt(1/zoomStep, image.Point{400, 300})
+	}
+	if c.Zoom != minZoom {
+		t.Fatalf("zoom out: got %v, want minZoom %v", c.Zoom, minZoom)
+	}
+
+	for i := 0; i < 40; i++ {
+		c.ZoomAt(zoomStep, image.Point{400, 300})
+	}
+	if c.Zoom != maxZoom {
+		t.Fatalf("zoom in: got %v, want maxZoom %v", c.Zoom, maxZoom)
+	}
+}
+
+func TestCamera_ZoomAtKeepsScreenPointFixed(t *testing.T) {
+	c := NewCamera(orb.Point{500, 500}, image.Point{800, 600})
+	screenPt := image.Point{200, 150}
+	before := c.ScreenToWorld(screenPt)
+
+	c.ZoomAt(zoomStep, screenPt)
+
+	after := c.ScreenToWorld(screenPt)
+	if !approxEqual(before.X(), after.X(), 1) || !approxEqual(before.Y(), after.Y(), 1) {
+		t.Fatalf("zoom anchor moved: before %v, after %v", before, after)
+	}
+}
@@ -0,0 +1,210 @@
+// Package console implements an in-game developer console: a backtick-
+// toggled text input overlaid at the bottom of the screen, with commands
+// registered by name and their output logged in a scrollback above the
+// prompt. It lets new entity kinds and behaviours be exercised at runtime
+// without recompiling.
+package console
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/oakmound/oak/v4/event"
+	"github.com/oakmound/oak/v4/key"
+	"github.com/oakmound/oak/v4/render"
+	"github.com/oakmound/oak/v4/scene"
+	"golang.org/x/image/font"
+)
+
+// maxLogLines caps the scrollback kept above the prompt.
+const maxLogLines = 10
+
+// Handler runs a console command against its whitespace-separated
+// arguments (the command name itself is not included).
+type Handler func(args []string) error
+
+type command struct {
+	help string
+	fn   Handler
+}
+
+// Console is a backtick-toggled overlay: a single-line text input at the
+// bottom of the screen and a scrollback log above it. Commands are
+// registered with Bind and dispatched by their first token.
+type Console struct {
+	mu       sync.Mutex
+	font     font.Face
+	commands map[string]command
+
+	open  bool
+	input string
+	lines []string
+
+	prompt *render.Text
+	log    *render.Text
+}
+
+// New creates an empty Console that renders with face.
+func New(face font.Face) *Console {
+	return &Console{font: face, commands: make(map[string]command)}
+}
+
+// Bind registers fn under name, with help shown by the built-in `help`
+// command. Binding the same name twice replaces the previous handler.
+func (c *Console) Bind(name, help string, fn Handler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.commands[name] = command{help: help, fn: fn}
+}
+
+// Logf appends a formatted line to the scrollback.
+func (c *Console) Logf(format string, args ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lines = append(c.lines, fmt.Sprintf(format, args...))
+	if len(c.lines) > maxLogLines {
+		c.lines = c.lines[len(c.lines)-maxLogLines:]
+	}
+	c.redrawLocked()
+}
+
+// Attach wires the backtick toggle and input handling into ctx. Call it
+// from every scene's Start func that should support the console.
+func (c *Console) Attach(ctx *scene.Context) {
+	bounds := ctx.Window.Bounds()
+	c.prompt = render.NewText("", 8, float64(bounds.Y()-24))
+	c.prompt.SetFont(c.font)
+	c.log = render.NewText("", 8, float64(bounds.Y()-24-maxLogLines*16))
+	c.log.SetFont(c.font)
+
+	event.GlobalBind(ctx, key.Down(key.Backtick), func(key.Event) event.Response {
+		c.toggle()
+		return 0
+	})
+	event.GlobalBind(ctx, key.Down(key.Enter), func(key.Event) event.Response {
+		if !c.IsOpen() {
+			return 0
+		}
+		c.submit()
+		return 0
+	})
+	event.GlobalBind(ctx, key.Down(key.Backspace), func(key.Event) event.Response {
+		if !c.IsOpen() {
+			return 0
+		}
+		c.backspace()
+		return 0
+	})
+	event.GlobalBind(ctx, key.AnyDown, func(ev key.Event) event.Response {
+		if !c.IsOpen() {
+			return 0
+		}
+		if r, ok := keyRune(ev.Code); ok {
+			c.typeRune(r)
+		}
+		return 0
+	})
+}
+
+// IsOpen reports whether the console overlay is currently shown and
+// capturing keystrokes. Scenes bind their own key.AnyDown/key.Down(Enter)
+// handlers for unrelated purposes (e.g. "press any key to continue") and
+// must check this first, since the console doesn't own those key events
+// exclusively.
+func (c *Console) IsOpen() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.open
+}
+
+func (c *Console) toggle() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.open = !c.open
+	if c.open {
+		render.Draw(c.prompt)
+		render.Draw(c.log)
+	} else {
+		c.prompt.Undraw()
+		c.log.Undraw()
+	}
+}
+
+func (c *Console) typeRune(r rune) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.open {
+		return
+	}
+	c.input += string(r)
+	c.redrawLocked()
+}
+
+func (c *Console) backspace() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.open || c.input == "" {
+		return
+	}
+	c.input = c.input[:len(c.input)-1]
+	c.redrawLocked()
+}
+
+func (c *Console) submit() {
+	c.mu.Lock()
+	if !c.open {
+		c.mu.Unlock()
+		return
+	}
+	line := c.input
+	c.input = ""
+	c.mu.Unlock()
+
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	c.Logf("> %s", line)
+	if err := c.run(line); err != nil {
+		c.Logf("error: %v", err)
+	}
+}
+
+func (c *Console) run(line string) error {
+	fields := strings.Fields(line)
+	name, args := fields[0], fields[1:]
+
+	c.mu.Lock()
+	cmd, ok := c.commands[name]
+	c.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("unknown command %q", name)
+	}
+	return cmd.fn(args)
+}
+
+func (c *Console) redrawLocked() {
+	c.prompt.SetString("> " + c.input)
+	c.prompt.SetFont(c.font)
+	c.log.SetString(strings.Join(c.lines, "\n"))
+	c.log.SetFont(c.font)
+}
+
+// keyRune maps a held key's code to the rune it types, for the small set of
+// keys the console cares about (letters, digits, space, minus). Keys
+// outside that set are ignored.
+func keyRune(code string) (rune, bool) {
+	if len(code) == 1 {
+		r := rune(code[0])
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r, true
+		}
+	}
+	switch code {
+	case "Space":
+		return ' ', true
+	case "Minus":
+		return '-', true
+	}
+	return 0, false
+}
@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.com/oakmound/oak/v4/dlog"
+	"github.com/paulmach/orb"
+
+	"github.com/arsham/lify/internal/entity/food"
+	"github.com/arsham/lify/internal/ui/console"
+)
+
+// newConsole builds the developer console with the built-in commands bound,
+// so it behaves the same whether it's attached from loadingScene or
+// startLifyScene.
+func (s *Scene) newConsole() *console.Console {
+	c := console.New(s.board.Font(AssetFontInfo))
+
+	c.Bind("spawn", "spawn <kind> <n> - add n entities of kind at random positions", func(args []string) error {
+		if len(args) != 2 {
+			return fmt.Errorf("usage: spawn <kind> <n>")
+		}
+		if args[0] != "herb" {
+			return fmt.Errorf("unknown kind %q", args[0])
+		}
+		n, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("parse count: %w", err)
+		}
+
+		bBounds := s.board.Bound()
+		for i := 0; i < n; i++ {
+			point := orb.Point{
+				float64(rand.Int31n(int32(bBounds.Max.X()))),
+				float64(rand.Int31n(int32(bBounds.Max.Y()))),
+			}
+			// Decoded fresh per entity so each Entity.Draw's SetPos/Modify
+			// calls don't clobber a sprite shared with every other herb.
+			asset, err := s.board.Asset(AssetHerb1)
+			if err != nil {
+				return fmt.Errorf("getting herb asset: %w", err)
+			}
+			herb := food.NewHerb(fmt.Sprintf("Herb #%d", i))
+			e := NewEntity(herb, point, AssetHerb1, asset)
+			if err := s.board.Add(e); err != nil {
+				dlog.Error("Failed adding at:", point)
+			}
+		}
+		c.Logf("spawned %d herb(s)", n)
+		return nil
+	})
+
+	c.Bind("clear", "clear - remove every entity from the board", func(args []string) error {
+		s.board.Clear()
+		c.Logf("board cleared")
+		return nil
+	})
+
+	c.Bind("count", "count - print the number of entities on the board", func(args []string) error {
+		c.Logf("%d entities", s.board.Count())
+		return nil
+	})
+
+	c.Bind("goto", "goto <x> <y> - move the camera to a world position", func(args []string) error {
+		if len(args) != 2 {
+			return fmt.Errorf("usage: goto <x> <y>")
+		}
+		x, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			return fmt.Errorf("parse x: %w", err)
+		}
+		y, err := strconv.ParseFloat(args[1], 64)
+		if err != nil {
+			return fmt.Errorf("parse y: %w", err)
+		}
+		if s.camera == nil {
+			return fmt.Errorf("no camera in this scene")
+		}
+		s.camera.Pos = orb.Point{x, y}
+		return nil
+	})
+
+	c.Bind("fps", "fps - print the current frame rate", func(args []string) error {
+		c.Logf("%d fps", frameRate)
+		return nil
+	})
+
+	c.Bind("quit", "quit - exit the game", func(args []string) error {
+		s.win.Quit()
+		return nil
+	})
+
+	return c
+}
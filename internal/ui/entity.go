@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"image"
+
+	"github.com/oakmound/oak/v4/render"
+	"github.com/paulmach/orb"
+
+	"github.com/arsham/lify/internal/ecs"
+)
+
+// Behaviour is implemented by the entity kinds assembled into an Entity,
+// e.g. food.Herb. Component data lives in the World's component maps, not
+// on the behaviour value itself; Behaviour only needs to name the species
+// so NewEntity can seed ecs.Species.
+type Behaviour interface {
+	Species() string
+}
+
+// Entity bundles a World identity with the position and renderable asset a
+// Board draws it with. NewEntity is the only place these are assembled.
+type Entity struct {
+	id      ecs.EntityID
+	point   orb.Point
+	asset   render.Modifiable
+	assetID string
+	species string
+}
+
+// initialHunger is the Hunger.Value and Hunger.Max Board.Add seeds every
+// newly added entity with.
+const initialHunger = 100.0
+
+// NewEntity assembles an Entity from a Behaviour, its spawn point, and the
+// render asset it's drawn with, identified by assetID so Board.Add can seed
+// ecs.Renderable. It has no World identity until Board.Add assigns one.
+func NewEntity(b Behaviour, point orb.Point, assetID string, asset render.Modifiable) *Entity {
+	return &Entity{point: point, asset: asset, assetID: assetID, species: b.Species()}
+}
+
+// ID returns the entity's Board-scoped identity.
+func (e *Entity) ID() ecs.EntityID { return e.id }
+
+// Point returns the entity's current world position.
+func (e *Entity) Point() orb.Point { return e.point }
+
+// SetPoint updates the entity's world position directly. Callers mutating
+// a Board-managed entity must go through Board.Move instead, so the
+// spatial index stays in sync.
+func (e *Entity) SetPoint(p orb.Point) { e.point = p }
+
+// Draw positions the entity's asset at screenPt, scales it by zoom, and
+// draws it. Callers pass the entity's world position through
+// Camera.WorldToScreen and the camera's current Zoom so panning and
+// zooming actually move and magnify what's drawn, instead of just
+// narrowing the cull.
+func (e *Entity) Draw(screenPt image.Point, zoom float64) {
+	e.asset.SetPos(float64(screenPt.X), float64(screenPt.Y))
+	e.asset.Modify(render.Scale(zoom, zoom))
+	render.Draw(e.asset)
+}
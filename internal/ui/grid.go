@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"github.com/paulmach/orb"
+
+	"github.com/arsham/lify/internal/ecs"
+)
+
+// gridCellSize is the edge length, in world pixels, of a single grid cell.
+const gridCellSize = 256
+
+type cellKey [2]int
+
+// grid is a uniform-grid spatial index over a Board's entities, keyed by
+// gridCellSize cells. It lets EntitiesIn answer viewport queries in O(k)
+// where k is the number of entities actually inside the queried bound,
+// rather than scanning every entity on the Board.
+type grid struct {
+	cells map[cellKey]map[ecs.EntityID]*Entity
+}
+
+// newGrid creates an empty grid.
+func newGrid() *grid {
+	return &grid{cells: make(map[cellKey]map[ecs.EntityID]*Entity)}
+}
+
+func cellKeyFor(p orb.Point) cellKey {
+	return cellKey{floorDiv(int(p.X()), gridCellSize), floorDiv(int(p.Y()), gridCellSize)}
+}
+
+// floorDiv divides a by b rounding toward negative infinity, unlike Go's
+// native integer division which truncates toward zero. Without this, cells
+// on the negative side of an axis overlap with their positive counterpart
+// (e.g. both -1 and 1 would truncate into cell 0), so Query would miss
+// entities once the camera pans into negative world coordinates.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// Insert adds e to the grid at its current position.
+func (g *grid) Insert(e *Entity) {
+	k := cellKeyFor(e.Point())
+	bucket, ok := g.cells[k]
+	if !ok {
+		bucket = make(map[ecs.EntityID]*Entity)
+		g.cells[k] = bucket
+	}
+	bucket[e.ID()] = e
+}
+
+// Remove deletes the entity with the given ID from the grid, wherever its
+// cell happens to be.
+func (g *grid) Remove(id ecs.EntityID) {
+	for k, bucket := range g.cells {
+		if _, ok := bucket[id]; !ok {
+			continue
+		}
+		delete(bucket, id)
+		if len(bucket) == 0 {
+			delete(g.cells, k)
+		}
+		return
+	}
+}
+
+// Move relocates an already-inserted entity to newPoint, updating its cell
+// if it crossed a cell boundary.
+func (g *grid) Move(e *Entity, newPoint orb.Point) {
+	g.Remove(e.ID())
+	e.SetPoint(newPoint)
+	g.Insert(e)
+}
+
+// Query returns every entity whose exact position falls inside bound. It
+// only scans the cells that overlap bound, not the whole grid.
+func (g *grid) Query(bound orb.Bound) []*Entity {
+	minKey := cellKeyFor(bound.Min)
+	maxKey := cellKeyFor(bound.Max)
+
+	var out []*Entity
+	for x := minKey[0]; x <= maxKey[0]; x++ {
+		for y := minKey[1]; y <= maxKey[1]; y++ {
+			bucket, ok := g.cells[cellKey{x, y}]
+			if !ok {
+				continue
+			}
+			for _, e := range bucket {
+				if bound.Contains(e.Point()) {
+					out = append(out, e)
+				}
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/oakmound/oak/v4/render"
+	"github.com/paulmach/orb"
+
+	"github.com/arsham/lify/internal/ecs"
+)
+
+// benchGrid populates a grid with n entities spread across a worldSize x
+// worldSize area, so BenchmarkGridQuery_Viewport can measure Query's cost
+// as a function of the viewport's hit count k rather than the total n.
+func benchGrid(b *testing.B, n int, worldSize float64) *grid {
+	b.Helper()
+	g := newGrid()
+	for i := 0; i < n; i++ {
+		e := &Entity{
+			id:    ecs.EntityID(i + 1),
+			point: orb.Point{rand.Float64() * worldSize, rand.Float64() * worldSize},
+			asset: render.NewEmptySprite(0, 0, 1, 1),
+		}
+		g.Insert(e)
+	}
+	return g
+}
+
+// BenchmarkGridQuery_Viewport holds the viewport size fixed at a typical
+// 1920x1080 while growing the total entity count n. If Query were the
+// linear scan it replaced, this benchmark's ns/op would grow with n; since
+// it only walks the cells overlapping the viewport, ns/op instead tracks k
+// (roughly constant here, since entities stay spread across the same
+// worldSize area as n grows).
+func BenchmarkGridQuery_Viewport(b *testing.B) {
+	bound := orb.Bound{Min: orb.Point{2000, 300}, Max: orb.Point{2000 + 1920, 300 + 1080}}
+
+	for _, n := range []int{1_000, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			g := benchGrid(b, n, 20_000)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = g.Query(bound)
+			}
+		})
+	}
+}
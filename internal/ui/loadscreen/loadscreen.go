@@ -0,0 +1,114 @@
+// Package loadscreen provides a reusable progress overlay that any scene
+// can raise while a long-running operation is in flight, such as the
+// initial asset load or saving a snapshot from the main scene.
+package loadscreen
+
+import (
+	"fmt"
+	"image/color"
+	"sync"
+
+	"github.com/oakmound/oak/v4/render"
+	"github.com/oakmound/oak/v4/scene"
+	"golang.org/x/image/font"
+)
+
+// barWidth and barHeight size the progress bar drawn by Show.
+const (
+	barWidth  = 400
+	barHeight = 24
+)
+
+// Screen is a progress overlay with a label and a fill bar. It keeps its
+// own renderables so Show/Hide can be called repeatedly, from any scene,
+// without rebuilding them each time.
+type Screen struct {
+	mu     sync.Mutex
+	active bool
+
+	font  font.Face
+	label *render.Text
+	track *render.Sprite
+	fill  *render.Sprite
+
+	x, y float64
+}
+
+// New creates a Screen that renders its label and bar using face, anchored
+// at the given top-left position.
+func New(face font.Face, x, y float64) *Screen {
+	return &Screen{font: face, x: x, y: y}
+}
+
+// Show draws the overlay onto ctx's scene and marks the Screen active. It is
+// safe to call from the loading scene or from the main scene.
+func (s *Screen) Show(ctx *scene.Context, label string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.track = render.NewColorBoxM(barWidth, barHeight, color.RGBA{40, 40, 40, 255})
+	s.track.SetPos(s.x, s.y)
+	s.fill = render.NewColorBoxM(0, barHeight, color.RGBA{80, 200, 120, 255})
+	s.fill.SetPos(s.x, s.y)
+	s.label = render.NewText(label, s.x, s.y-20)
+	s.label.SetFont(s.font)
+
+	for _, r := range []render.Renderable{s.track, s.fill, s.label} {
+		if _, err := render.Draw(r); err != nil {
+			return fmt.Errorf("draw loadscreen element: %w", err)
+		}
+	}
+	s.active = true
+	return nil
+}
+
+// Hide undraws the overlay. It is a no-op if the Screen isn't active.
+func (s *Screen) Hide() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.active {
+		return
+	}
+	for _, r := range []render.Renderable{s.track, s.fill, s.label} {
+		if r != nil {
+			r.Undraw()
+		}
+	}
+	s.active = false
+}
+
+// SetProgress resizes the fill bar to reflect done/total, clamped to
+// [0, barWidth].
+func (s *Screen) SetProgress(done, total int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fill == nil || total <= 0 {
+		return
+	}
+	ratio := float64(done) / float64(total)
+	if ratio < 0 {
+		ratio = 0
+	} else if ratio > 1 {
+		ratio = 1
+	}
+	s.fill.Modify(render.ResizeNonUniform(int(barWidth*ratio), barHeight))
+}
+
+// SetLabel updates the text shown above the bar, e.g. the current loading
+// task.
+func (s *Screen) SetLabel(label string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.label == nil {
+		return
+	}
+	s.label.SetString(label)
+	s.label.SetFont(s.font)
+}
+
+// IsActive reports whether the Screen is currently shown.
+func (s *Screen) IsActive() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.active
+}
@@ -3,6 +3,7 @@ package ui
 import (
 	"context"
 	"fmt"
+	"image"
 	"image/color"
 	"math/rand"
 	"time"
@@ -11,25 +12,36 @@ import (
 	"github.com/oakmound/oak/v4/dlog"
 	"github.com/oakmound/oak/v4/event"
 	"github.com/oakmound/oak/v4/key"
+	"github.com/oakmound/oak/v4/mouse"
 	"github.com/oakmound/oak/v4/render"
 	"github.com/oakmound/oak/v4/scene"
 	"github.com/paulmach/orb"
 
 	"github.com/arsham/lify/internal/config"
+	"github.com/arsham/lify/internal/ecs"
 	"github.com/arsham/lify/internal/entity/food"
+	"github.com/arsham/lify/internal/ui/console"
+	"github.com/arsham/lify/internal/ui/loadscreen"
 )
 
 const (
 	sceneLoading = "loading_scene"
 	sceneLify    = "lify_scene"
+
+	// frameRate is both the simulation and draw rate; the fps console
+	// command reports it back.
+	frameRate = 60
 )
 
 // Scene is a struct that represents a scene in the UI. It manages the
 // transition between scenes and the rendering of the current scene.
 type Scene struct {
-	env   *config.Env
-	board *Board
-	win   *oak.Window
+	env     *config.Env
+	board   *Board
+	win     *oak.Window
+	loader  *loadscreen.Screen
+	console *console.Console
+	camera  *Camera
 }
 
 // NewScene creates a new Scene and sets up the drawing stack.
@@ -41,10 +53,12 @@ func NewScene(env *config.Env, b *Board) (*Scene, error) {
 	)
 	win := oak.NewWindow()
 	s := &Scene{
-		env:   env,
-		board: b,
-		win:   win,
+		env:    env,
+		board:  b,
+		win:    win,
+		loader: loadscreen.New(b.Font(AssetFontInfo), 0, 0),
 	}
+	s.console = s.newConsole()
 
 	err := win.AddScene(sceneLify, s.startLifyScene())
 	if err != nil {
@@ -61,8 +75,8 @@ func NewScene(env *config.Env, b *Board) (*Scene, error) {
 // Start starts the loading scene, and then transitions to the main scene.
 func (s *Scene) Start() error {
 	return s.win.Init(sceneLoading, func(c oak.Config) (oak.Config, error) {
-		c.FrameRate = 60
-		c.DrawFrameRate = 60
+		c.FrameRate = frameRate
+		c.DrawFrameRate = frameRate
 		c.Screen.Width = s.env.UI.Width
 		c.Screen.Height = s.env.UI.Height
 		c.Debug = oak.Debug{
@@ -84,6 +98,8 @@ func (s *Scene) Start() error {
 func (s *Scene) loadingScene() scene.Scene {
 	return scene.Scene{
 		Start: func(ctx *scene.Context) {
+			s.console.Attach(ctx)
+
 			titleText := render.NewText("Loading assets...", 0, 0)
 			titleText.SetFont(s.board.Font(AssetFontInfo))
 			putCentre(ctx, titleText, axixXY)
@@ -95,37 +111,61 @@ func (s *Scene) loadingScene() scene.Scene {
 			}
 
 			event.GlobalBind(ctx, key.Down(key.Q), func(key.Event) event.Response {
+				if s.console.IsOpen() {
+					return 0
+				}
 				ctx.Window.Quit()
 				return 0
 			})
 
+			if err := s.loader.Show(ctx, "Loading assets..."); err != nil {
+				dlog.Error("Failed showing load screen:", err)
+				ctx.Window.Quit()
+				return
+			}
+
 			go func() {
-				err := s.board.Load()
-				if err != nil {
-					dlog.Error("Failed loading assets:", err)
-					ctx.Window.Quit()
-					return
+				const herbCount = 10000
+				// assetSteps is Board.Load's share of the combined bar; the
+				// population loop picks up where it leaves off instead of
+				// resetting the bar to 0% for its own phase.
+				assetSteps := len(boardAssetIDs)
+				totalSteps := assetSteps + herbCount
+				progress := func(done, _ int, label string) {
+					s.loader.SetLabel(label)
+					s.loader.SetProgress(done, totalSteps)
 				}
-				asset, err := s.board.Asset(AssetHerb1)
+
+				err := s.board.Load(progress)
 				if err != nil {
-					dlog.Error("getting herb asset:", err)
+					dlog.Error("Failed loading assets:", err)
 					ctx.Window.Quit()
 					return
 				}
-
 				bBounds := s.board.Bound()
-				for i := 0; i < 10000; i++ {
+				for i := 0; i < herbCount; i++ {
 					point := orb.Point{
 						float64(rand.Int31n(int32(bBounds.Max.X()))),
 						float64(rand.Int31n(int32(bBounds.Max.Y()))),
 					}
+					// Decoded fresh per entity: Entity.Draw calls SetPos/Modify
+					// on its own asset every frame, so a shared render.Modifiable
+					// would have every entity clobber the last one's position.
+					asset, err := s.board.Asset(AssetHerb1)
+					if err != nil {
+						dlog.Error("getting herb asset:", err)
+						ctx.Window.Quit()
+						return
+					}
 					herb := food.NewHerb(fmt.Sprintf("Herb #%d", i))
-					e := NewEntity(herb, point, asset)
+					e := NewEntity(herb, point, AssetHerb1, asset)
 					if err := s.board.Add(e); err != nil {
 						dlog.Error("Failed adding at:", point)
 					}
+					progress(assetSteps+i+1, herbCount, "Populating world...")
 				}
 
+				s.loader.Hide()
 				titleText.SetString("Assets have been loaded")
 				titleText.SetFont(s.board.Font(AssetFontInfo))
 				putCentre(ctx, titleText, axixXY)
@@ -142,6 +182,9 @@ func (s *Scene) loadingScene() scene.Scene {
 				}
 
 				event.GlobalBind(ctx, key.AnyDown, func(key.Event) event.Response {
+					if s.console.IsOpen() {
+						return 0
+					}
 					ctx.Window.NextScene()
 					return 0
 				})
@@ -153,19 +196,41 @@ func (s *Scene) loadingScene() scene.Scene {
 	}
 }
 
+// panSpeed is how many world units the camera moves per second of held
+// arrow/WASD input. zoomStep is the multiplicative factor applied per wheel
+// notch or +/- press.
+const (
+	panSpeed = 600.0
+	zoomStep = 1.1
+)
+
 func (s *Scene) startLifyScene() scene.Scene {
 	return scene.Scene{
 		Start: func(ctx *scene.Context) {
+			s.console.Attach(ctx)
+
 			event.GlobalBind(ctx, key.Down(key.Q), func(key.Event) event.Response {
+				if s.console.IsOpen() {
+					return 0
+				}
 				ctx.Window.Quit()
 				return 0
 			})
 			s.win.ParentContext = context.WithValue(context.Background(), preLoadTimeStr, time.Now())
-			screen := render.NewColorBoxM(s.win.Bounds().X(), s.win.Bounds().Y(), color.RGBA{0, 0, 0, 0})
 
-			for _, e := range s.board.EntitiesIn(2000, 300, 2000+1920, 300+1080) {
-				e.Draw(screen, orb.Point{2000, 300})
+			winBounds := s.win.Bounds()
+			camera := NewCamera(orb.Point{2000 + 1920/2, 300 + 1080/2}, image.Point{winBounds.X(), winBounds.Y()})
+			s.camera = camera
+			screen := render.NewColorBoxM(winBounds.X(), winBounds.Y(), color.RGBA{0, 0, 0, 0})
+
+			redraw := func() {
+				screen.Clear()
+				wb := camera.WorldBounds()
+				for _, e := range s.board.EntitiesIn(int(wb.Min.X()), int(wb.Min.Y()), int(wb.Max.X()), int(wb.Max.Y())) {
+					e.Draw(camera.WorldToScreen(e.Point()), camera.Zoom)
+				}
 			}
+			redraw()
 			_, err := render.Draw(screen)
 			if err != nil {
 				dlog.Error("Failed rendering text:", err)
@@ -173,6 +238,62 @@ func (s *Scene) startLifyScene() scene.Scene {
 				return
 			}
 			s.win.SetLoadingRenderable(screen)
+
+			event.GlobalBind(ctx, mouse.ScrollDown, func(me mouse.Event) event.Response {
+				if s.console.IsOpen() {
+					return 0
+				}
+				camera.ZoomAt(1/zoomStep, image.Point{int(me.X), int(me.Y)})
+				return 0
+			})
+			event.GlobalBind(ctx, mouse.ScrollUp, func(me mouse.Event) event.Response {
+				if s.console.IsOpen() {
+					return 0
+				}
+				camera.ZoomAt(zoomStep, image.Point{int(me.X), int(me.Y)})
+				return 0
+			})
+			event.GlobalBind(ctx, key.Down(key.Equals), func(key.Event) event.Response {
+				if s.console.IsOpen() {
+					return 0
+				}
+				camera.ZoomAt(zoomStep, image.Point{winBounds.X() / 2, winBounds.Y() / 2})
+				return 0
+			})
+			event.GlobalBind(ctx, key.Down(key.Minus), func(key.Event) event.Response {
+				if s.console.IsOpen() {
+					return 0
+				}
+				camera.ZoomAt(1/zoomStep, image.Point{winBounds.X() / 2, winBounds.Y() / 2})
+				return 0
+			})
+
+			var world *ecs.World = s.board.World()
+			event.GlobalBind(ctx, event.Enter, func(event.EnterPayload) event.Response {
+				last, _ := s.win.ParentContext.Value(preLoadTimeStr).(time.Time)
+				now := time.Now()
+				dt := now.Sub(last).Seconds()
+				s.win.ParentContext = context.WithValue(s.win.ParentContext, preLoadTimeStr, now)
+
+				if !s.console.IsOpen() {
+					if key.IsDown(key.W) || key.IsDown(key.Up) {
+						camera.Pan(0, -panSpeed*dt)
+					}
+					if key.IsDown(key.S) || key.IsDown(key.Down) {
+						camera.Pan(0, panSpeed*dt)
+					}
+					if key.IsDown(key.A) || key.IsDown(key.Left) {
+						camera.Pan(-panSpeed*dt, 0)
+					}
+					if key.IsDown(key.D) || key.IsDown(key.Right) {
+						camera.Pan(panSpeed*dt, 0)
+					}
+				}
+
+				world.Tick(dt)
+				redraw()
+				return 0
+			})
 		},
 		End: func() (string, *scene.Result) {
 			return sceneLify, nil
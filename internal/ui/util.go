@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"github.com/oakmound/oak/v4/scene"
+)
+
+// AssetHerb1 and AssetFontInfo are the IDs the Board resolves through its
+// AssetManager for the herb sprite and the UI font, respectively.
+const (
+	AssetHerb1    = "herb1.png"
+	AssetFontInfo = "info.ttf"
+)
+
+// boardAssetIDs are the assets Board.Load resolves up front. Its length is
+// the number of loading steps before the loading scene moves on to
+// populating the world, so callers reporting combined progress across both
+// phases can use it to size their shared total.
+var boardAssetIDs = []string{AssetHerb1, AssetFontInfo}
+
+// axis selects which dimensions putCentre should centre a renderable on.
+type axis int
+
+const (
+	axixX axis = 1 << iota
+	axixY
+	axixXY = axixX | axixY
+)
+
+// positional is implemented by renderables whose position putCentre can
+// read and rewrite, e.g. *render.Text.
+type positional interface {
+	GetPos() (float64, float64)
+	SetPos(x, y float64)
+}
+
+// putCentre repositions r so that the requested axis/axes are centred in
+// ctx's window, leaving any other axis where it was.
+func putCentre(ctx *scene.Context, r positional, which axis) {
+	bounds := ctx.Window.Bounds()
+	x, y := r.GetPos()
+	if which&axixX != 0 {
+		x = float64(bounds.X()) / 2
+	}
+	if which&axixY != 0 {
+		y = float64(bounds.Y()) / 2
+	}
+	r.SetPos(x, y)
+}
+
+// preLoadTimeStr keys the last-tick timestamp stashed on a window's
+// ParentContext, so per-frame handlers can compute dt between ticks.
+type contextKey int
+
+const preLoadTimeStr contextKey = iota